@@ -49,14 +49,22 @@ type Session struct {
 		String map[string]interface{} `json:"string"`
 	} `json:"attributes"`
 	User struct {
-		UserID      string `json:"userId"`
-		AccessToken string `json:"accessToken"`
+		UserID      string      `json:"userId"`
+		AccessToken string      `json:"accessToken"`
+		Permissions Permissions `json:"permissions"`
 	} `json:"user"`
 	Application struct {
 		ApplicationID string `json:"applicationId"`
 	} `json:"application"`
 }
 
+// Permissions contains the consent token Alexa sends when the user has
+// granted this skill one or more permissions (device address, email, name,
+// reminders, etc). An empty ConsentToken means no permissions were granted.
+type Permissions struct {
+	ConsentToken string `json:"consentToken"`
+}
+
 type Context struct {
 	AudioPlayer struct {
 		PlayerActivity string `json:"playerActivity"`
@@ -69,7 +77,8 @@ type Context struct {
 			ApplicationID string `json:"applicationId"`
 		} `json:"application"`
 		User struct {
-			UserID string `json:"userId"`
+			UserID      string      `json:"userId"`
+			Permissions Permissions `json:"permissions"`
 		} `json:"user"`
 		Device struct {
 			DeviceID            string `json:"deviceId"`
@@ -80,6 +89,11 @@ type Context struct {
 					TemplateVersion string `json:"templateVersion"`
 					MarkupVersion   string `json:"markupVersion"`
 				} `json:"Display"`
+				AlexaPresentationAPL struct {
+					Runtime struct {
+						MaxVersion string `json:"maxVersion"`
+					} `json:"runtime"`
+				} `json:"Alexa.Presentation.APL"`
 			} `json:"supportedInterfaces"`
 		} `json:"device"`
 		APIEndpoint    string `json:"apiEndpoint"`
@@ -155,18 +169,28 @@ type Response struct {
 
 // OutputSpeech contains the data the defines what Alexa should say to the user.
 type OutputSpeech struct {
-	Type string `json:"type"`
-	Text string `json:"text,omitempty"`
-	SSML string `json:"ssml,omitempty"`
-}
+	Type         string `json:"type"`
+	Text         string `json:"text,omitempty"`
+	SSML         string `json:"ssml,omitempty"`
+	PlayBehavior string `json:"playBehavior,omitempty"`
+}
+
+// PlayBehavior values control how OutputSpeech is queued against any audio
+// Alexa may already be speaking.
+const (
+	PlayBehaviorReplaceAll      = "REPLACE_ALL"
+	PlayBehaviorReplaceEnqueued = "REPLACE_ENQUEUED"
+	PlayBehaviorEnqueue         = "ENQUEUE"
+)
 
 // Card contains the data displayed to the user by the Alexa app.
 type Card struct {
-	Type    string `json:"type"`
-	Title   string `json:"title,omitempty"`
-	Content string `json:"content,omitempty"`
-	Text    string `json:"text,omitempty"`
-	Image   *Image `json:"image,omitempty"`
+	Type        string   `json:"type"`
+	Title       string   `json:"title,omitempty"`
+	Content     string   `json:"content,omitempty"`
+	Text        string   `json:"text,omitempty"`
+	Image       *Image   `json:"image,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
 }
 
 // Image provides URL(s) to the image to display in resposne to the request.
@@ -189,7 +213,18 @@ type AudioPlayerDirective struct {
 
 // AudioItem contains an audio Stream definition for playback.
 type AudioItem struct {
-	Stream Stream `json:"stream,omitempty"`
+	Stream   Stream             `json:"stream,omitempty"`
+	Metadata *AudioItemMetadata `json:"metadata,omitempty"`
+}
+
+// AudioItemMetadata contains the now-playing information shown on the
+// screen of multimodal devices (Echo Show, Echo Spot) while an AudioItem
+// plays.
+type AudioItemMetadata struct {
+	Title           string        `json:"title,omitempty"`
+	Subtitle        string        `json:"subtitle,omitempty"`
+	Art             *DisplayImage `json:"art,omitempty"`
+	BackgroundImage *DisplayImage `json:"backgroundImage,omitempty"`
 }
 
 // VideoAppDirective contains device level instructions on how to handle the response.
@@ -357,16 +392,37 @@ func (r *Response) SetLinkAccountCard() {
 	r.Card = &Card{Type: "LinkAccount"}
 }
 
+// SetAskForPermissionsConsentCard creates a card prompting the user to
+// grant the skill the given permissions (e.g. "read::alexa:device:all:address")
+// from within the Alexa app.
+func (r *Response) SetAskForPermissionsConsentCard(permissions []string) {
+	r.Card = &Card{Type: "AskForPermissionsConsent", Permissions: permissions}
+}
+
 // SetOutputText sets the OutputSpeech type to text and sets the value specified.
 func (r *Response) SetOutputText(text string) {
 	r.OutputSpeech = &OutputSpeech{Type: "PlainText", Text: text}
 }
 
+// SetOutputTextWithPlayBehavior sets the OutputSpeech type to text and sets
+// the value and PlayBehavior specified, allowing speech to be enqueued
+// instead of always replacing what Alexa is currently speaking.
+func (r *Response) SetOutputTextWithPlayBehavior(text, playBehavior string) {
+	r.OutputSpeech = &OutputSpeech{Type: "PlainText", Text: text, PlayBehavior: playBehavior}
+}
+
 // SetOutputSSML sets the OutputSpeech type to ssml and sets the value specified.
 func (r *Response) SetOutputSSML(ssml string) {
 	r.OutputSpeech = &OutputSpeech{Type: "SSML", SSML: ssml}
 }
 
+// SetOutputSSMLWithPlayBehavior sets the OutputSpeech type to ssml and sets
+// the value and PlayBehavior specified, allowing speech to be enqueued
+// instead of always replacing what Alexa is currently speaking.
+func (r *Response) SetOutputSSMLWithPlayBehavior(ssml, playBehavior string) {
+	r.OutputSpeech = &OutputSpeech{Type: "SSML", SSML: ssml, PlayBehavior: playBehavior}
+}
+
 // SetRepromptText created a Reprompt if needed and sets the OutputSpeech type to text and sets the value specified.
 func (r *Response) SetRepromptText(text string) {
 	if r.Reprompt == nil {
@@ -399,6 +455,24 @@ func (r *Response) AddAudioPlayer(playerType, playBehavior, streamToken, url str
 	r.Directives = append(r.Directives, d)
 }
 
+// AddAudioPlayerWithMetadata adds an AudioPlayer directive to the Response,
+// including now-playing metadata for the screen of multimodal devices.
+func (r *Response) AddAudioPlayerWithMetadata(playerType, playBehavior, streamToken, url string, offsetInMilliseconds int, meta *AudioItemMetadata) {
+	d := AudioPlayerDirective{
+		Type:         playerType,
+		PlayBehavior: playBehavior,
+		AudioItem: &AudioItem{
+			Stream: Stream{
+				Token:                streamToken,
+				URL:                  url,
+				OffsetInMilliseconds: offsetInMilliseconds,
+			},
+			Metadata: meta,
+		},
+	}
+	r.Directives = append(r.Directives, d)
+}
+
 // AddVideoApp adds a VideoApp directive to the Response
 func (r *Response) AddVideoApp(appType, sourceFile, title, subtitle string) {
 	d := VideoAppDirective{
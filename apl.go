@@ -0,0 +1,53 @@
+package alexa
+
+import "encoding/json"
+
+// APLRenderDocumentDirective renders an APL document on devices that
+// support the Alexa.Presentation.APL interface.
+type APLRenderDocumentDirective struct {
+	Type        string                 `json:"type"`
+	Token       string                 `json:"token"`
+	Document    json.RawMessage        `json:"document"`
+	DataSources map[string]interface{} `json:"datasources,omitempty"`
+	Sources     map[string]interface{} `json:"sources,omitempty"`
+}
+
+// APLExecuteCommandsDirective runs a list of APL commands (e.g. SpeakItem,
+// AutoPage, SendEvent) against a document already rendered on the device.
+type APLExecuteCommandsDirective struct {
+	Type     string        `json:"type"`
+	Token    string        `json:"token"`
+	Commands []interface{} `json:"commands"`
+}
+
+// AddAPLDocument adds an Alexa.Presentation.APL.RenderDocument directive to
+// the Response, rendering document on devices that support APL.
+func (r *Response) AddAPLDocument(token string, document json.RawMessage, dataSources map[string]interface{}) {
+	r.AddAPLDocumentWithSources(token, document, dataSources, nil)
+}
+
+// AddAPLDocumentWithSources adds an Alexa.Presentation.APL.RenderDocument
+// directive to the Response, including the sources (e.g. images or videos
+// referenced by the document) APL needs to resolve at render time.
+func (r *Response) AddAPLDocumentWithSources(token string, document json.RawMessage, dataSources map[string]interface{}, sources map[string]interface{}) {
+	d := APLRenderDocumentDirective{
+		Type:        "Alexa.Presentation.APL.RenderDocument",
+		Token:       token,
+		Document:    document,
+		DataSources: dataSources,
+		Sources:     sources,
+	}
+	r.Directives = append(r.Directives, d)
+}
+
+// AddAPLCommands adds an Alexa.Presentation.APL.ExecuteCommands directive to
+// the Response, running commands against the document already rendered
+// under token.
+func (r *Response) AddAPLCommands(token string, commands ...interface{}) {
+	d := APLExecuteCommandsDirective{
+		Type:     "Alexa.Presentation.APL.ExecuteCommands",
+		Token:    token,
+		Commands: commands,
+	}
+	r.Directives = append(r.Directives, d)
+}
@@ -0,0 +1,119 @@
+package alexa
+
+// ResponseBuilder provides a fluent, chainable alternative to building a
+// ResponseEnvelope by reaching into Response and ResponseEnvelope directly.
+// It is modeled on the builders in the official ASK SDKs: each method
+// mutates and returns the same builder, and Build() produces the finished
+// ResponseEnvelope.
+type ResponseBuilder struct {
+	envelope *ResponseEnvelope
+}
+
+// NewResponseBuilder creates a ResponseBuilder for a fresh ResponseEnvelope.
+func NewResponseBuilder() *ResponseBuilder {
+	return &ResponseBuilder{
+		envelope: &ResponseEnvelope{
+			Version:  sdkVersion,
+			Response: &Response{},
+		},
+	}
+}
+
+// Speak sets the response's OutputSpeech to plain text.
+func (b *ResponseBuilder) Speak(text string) *ResponseBuilder {
+	b.envelope.Response.SetOutputText(text)
+	return b
+}
+
+// SpeakWithPlayBehavior sets the response's OutputSpeech to plain text,
+// queuing it relative to any audio already playing according to
+// playBehavior (one of the PlayBehavior constants).
+func (b *ResponseBuilder) SpeakWithPlayBehavior(text, playBehavior string) *ResponseBuilder {
+	b.envelope.Response.SetOutputTextWithPlayBehavior(text, playBehavior)
+	return b
+}
+
+// SpeakSSML sets the response's OutputSpeech to SSML.
+func (b *ResponseBuilder) SpeakSSML(ssml string) *ResponseBuilder {
+	b.envelope.Response.SetOutputSSML(ssml)
+	return b
+}
+
+// SpeakSSMLWithPlayBehavior sets the response's OutputSpeech to SSML,
+// queuing it relative to any audio already playing according to
+// playBehavior (one of the PlayBehavior constants).
+func (b *ResponseBuilder) SpeakSSMLWithPlayBehavior(ssml, playBehavior string) *ResponseBuilder {
+	b.envelope.Response.SetOutputSSMLWithPlayBehavior(ssml, playBehavior)
+	return b
+}
+
+// Reprompt sets the response's Reprompt to plain text.
+func (b *ResponseBuilder) Reprompt(text string) *ResponseBuilder {
+	b.envelope.Response.SetRepromptText(text)
+	return b
+}
+
+// RepromptSSML sets the response's Reprompt to SSML.
+func (b *ResponseBuilder) RepromptSSML(ssml string) *ResponseBuilder {
+	b.envelope.Response.SetRepromptSSML(ssml)
+	return b
+}
+
+// WithSimpleCard attaches a Simple card to the response.
+func (b *ResponseBuilder) WithSimpleCard(title, content string) *ResponseBuilder {
+	b.envelope.Response.SetSimpleCard(title, content)
+	return b
+}
+
+// WithStandardCard attaches a Standard card to the response.
+func (b *ResponseBuilder) WithStandardCard(title, text, smallImageURL, largeImageURL string) *ResponseBuilder {
+	b.envelope.Response.SetStandardCard(title, text, smallImageURL, largeImageURL)
+	return b
+}
+
+// WithLinkAccountCard attaches a LinkAccount card to the response.
+func (b *ResponseBuilder) WithLinkAccountCard() *ResponseBuilder {
+	b.envelope.Response.SetLinkAccountCard()
+	return b
+}
+
+// WithAskForPermissionsConsentCard attaches an AskForPermissionsConsent
+// card requesting the given permissions to the response.
+func (b *ResponseBuilder) WithAskForPermissionsConsentCard(permissions []string) *ResponseBuilder {
+	b.envelope.Response.SetAskForPermissionsConsentCard(permissions)
+	return b
+}
+
+// WithShouldEndSession sets whether the session should end after this response.
+func (b *ResponseBuilder) WithShouldEndSession(shouldEndSession bool) *ResponseBuilder {
+	b.envelope.Response.ShouldSessionEnd = &shouldEndSession
+	return b
+}
+
+// AddDirective appends a directive (e.g. an AudioPlayerDirective or
+// APLRenderDocumentDirective) to the response.
+func (b *ResponseBuilder) AddDirective(directive interface{}) *ResponseBuilder {
+	b.envelope.Response.Directives = append(b.envelope.Response.Directives, directive)
+	return b
+}
+
+// WithSessionAttributes replaces the envelope's session attributes wholesale.
+func (b *ResponseBuilder) WithSessionAttributes(attributes map[string]interface{}) *ResponseBuilder {
+	b.envelope.SessionAttributes = attributes
+	return b
+}
+
+// AddSessionAttribute sets a single session attribute, initializing the
+// attributes map if necessary.
+func (b *ResponseBuilder) AddSessionAttribute(key string, value interface{}) *ResponseBuilder {
+	if b.envelope.SessionAttributes == nil {
+		b.envelope.SessionAttributes = make(map[string]interface{})
+	}
+	b.envelope.SessionAttributes[key] = value
+	return b
+}
+
+// Build returns the finished ResponseEnvelope.
+func (b *ResponseBuilder) Build() *ResponseEnvelope {
+	return b.envelope
+}
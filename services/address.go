@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Address is the device address returned by the Alexa Device Address API.
+type Address struct {
+	AddressLine1     string `json:"addressLine1"`
+	AddressLine2     string `json:"addressLine2"`
+	AddressLine3     string `json:"addressLine3"`
+	City             string `json:"city"`
+	StateOrRegion    string `json:"stateOrRegion"`
+	CountryCode      string `json:"countryCode"`
+	PostalCode       string `json:"postalCode"`
+	DistrictOrCounty string `json:"districtOrCounty"`
+}
+
+// CountryAndPostalCode is the coarser device location returned when a skill
+// only has the read::alexa:device:all:address:country_and_postal_code
+// permission rather than full address access.
+type CountryAndPostalCode struct {
+	CountryCode string `json:"countryCode"`
+	PostalCode  string `json:"postalCode"`
+}
+
+// AddressClient calls the Alexa Device Address API.
+type AddressClient struct {
+	client client
+}
+
+// NewAddressClient builds an AddressClient that authenticates with token
+// against endpoint, the APIAccessToken and APIEndpoint from the current
+// request's Context.System. A nil httpClient uses http.DefaultClient.
+func NewAddressClient(endpoint, token string, httpClient *http.Client) *AddressClient {
+	return &AddressClient{client: newClient(endpoint, token, httpClient)}
+}
+
+// GetFullAddress returns the complete address registered to deviceID. It
+// requires the read::alexa:device:all:address permission; ErrPermissionRequired
+// is returned if the user has not granted it.
+func (c *AddressClient) GetFullAddress(ctx context.Context, deviceID string) (*Address, error) {
+	var address Address
+	path := fmt.Sprintf("/v1/devices/%s/settings/address", url.PathEscape(deviceID))
+	if err := c.client.get(ctx, path, &address); err != nil {
+		return nil, err
+	}
+	return &address, nil
+}
+
+// GetCountryAndPostalCode returns the country and postal code registered to
+// deviceID. It requires the
+// read::alexa:device:all:address:country_and_postal_code permission;
+// ErrPermissionRequired is returned if the user has not granted it.
+func (c *AddressClient) GetCountryAndPostalCode(ctx context.Context, deviceID string) (*CountryAndPostalCode, error) {
+	var result CountryAndPostalCode
+	path := fmt.Sprintf("/v1/devices/%s/settings/address/countryAndPostalCode", url.PathEscape(deviceID))
+	if err := c.client.get(ctx, path, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,94 @@
+// Package services provides clients for the Alexa web services a skill can
+// call using the APIEndpoint and APIAccessToken supplied on Context.System,
+// gated behind the permissions the user has granted the skill (see
+// alexa.Permissions and alexa.Response.SetAskForPermissionsConsentCard).
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+// ErrPermissionRequired is returned when the Alexa service rejects a
+// request because the user has not granted the permission the call
+// requires.
+var ErrPermissionRequired = errors.New("alexa/services: user has not granted the required permission")
+
+// client performs authenticated requests against the Alexa APIEndpoint for
+// the current request.
+type client struct {
+	endpoint   string
+	token      string
+	httpClient *http.Client
+}
+
+// newClient builds a client for endpoint, authenticating with token. If
+// httpClient is nil, http.DefaultClient is used.
+func newClient(endpoint, token string, httpClient *http.Client) client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return client{endpoint: endpoint, token: token, httpClient: httpClient}
+}
+
+// do performs method against path, encoding reqBody as JSON if present and
+// decoding the response body into respOut if present. ctx bounds the
+// request so callers running inside a Lambda-backed handler can cancel or
+// time it out along with the rest of the invocation.
+func (c client) do(ctx context.Context, method, path string, reqBody interface{}, respOut interface{}) error {
+	var body *bytes.Reader
+	if reqBody != nil {
+		encoded, err := json.Marshal(reqBody)
+		if err != nil {
+			return fmt.Errorf("alexa/services: unable to encode request body: %v", err)
+		}
+		body = bytes.NewReader(encoded)
+	} else {
+		body = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+path, body)
+	if err != nil {
+		return fmt.Errorf("alexa/services: unable to build request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	if reqBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("alexa/services: request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusForbidden {
+		return ErrPermissionRequired
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		data, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("alexa/services: request to %s failed with status %d: %s", path, resp.StatusCode, data)
+	}
+
+	if respOut == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(respOut)
+}
+
+func (c client) get(ctx context.Context, path string, respOut interface{}) error {
+	return c.do(ctx, http.MethodGet, path, nil, respOut)
+}
+
+func (c client) post(ctx context.Context, path string, reqBody interface{}, respOut interface{}) error {
+	return c.do(ctx, http.MethodPost, path, reqBody, respOut)
+}
+
+func (c client) delete(ctx context.Context, path string) error {
+	return c.do(ctx, http.MethodDelete, path, nil, nil)
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"net/http"
+)
+
+// MobileNumber is the phone number returned by the Alexa Customer Profile
+// API.
+type MobileNumber struct {
+	CountryCode string `json:"countryCode"`
+	PhoneNumber string `json:"phoneNumber"`
+}
+
+// ProfileClient calls the Alexa Customer Profile API.
+type ProfileClient struct {
+	client client
+}
+
+// NewProfileClient builds a ProfileClient that authenticates with token
+// against endpoint, the APIAccessToken and APIEndpoint from the current
+// request's Context.System. A nil httpClient uses http.DefaultClient.
+func NewProfileClient(endpoint, token string, httpClient *http.Client) *ProfileClient {
+	return &ProfileClient{client: newClient(endpoint, token, httpClient)}
+}
+
+// GetEmail returns the user's email address. It requires the
+// alexa::profile:email:read permission; ErrPermissionRequired is returned
+// if the user has not granted it.
+func (c *ProfileClient) GetEmail(ctx context.Context) (string, error) {
+	var email string
+	if err := c.client.get(ctx, "/v2/accounts/~current/settings/Profile.email", &email); err != nil {
+		return "", err
+	}
+	return email, nil
+}
+
+// GetName returns the user's full name. It requires the
+// alexa::profile:name:read permission; ErrPermissionRequired is returned if
+// the user has not granted it.
+func (c *ProfileClient) GetName(ctx context.Context) (string, error) {
+	var name string
+	if err := c.client.get(ctx, "/v2/accounts/~current/settings/Profile.name", &name); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// GetMobileNumber returns the user's phone number. It requires the
+// alexa::profile:mobile_number:read permission; ErrPermissionRequired is
+// returned if the user has not granted it.
+func (c *ProfileClient) GetMobileNumber(ctx context.Context) (*MobileNumber, error) {
+	var number MobileNumber
+	if err := c.client.get(ctx, "/v2/accounts/~current/settings/Profile.mobileNumber", &number); err != nil {
+		return nil, err
+	}
+	return &number, nil
+}
@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ReminderTrigger describes when a reminder fires.
+type ReminderTrigger struct {
+	Type           string `json:"type"`
+	ScheduledTime  string `json:"scheduledTime,omitempty"`
+	TimeZoneID     string `json:"timeZoneId,omitempty"`
+	RecurrenceFreq string `json:"recurrenceFreq,omitempty"`
+}
+
+// ReminderSpokenText is a localized piece of reminder speech.
+type ReminderSpokenText struct {
+	Locale string `json:"locale"`
+	Text   string `json:"text"`
+}
+
+// ReminderAlertInfo contains the content Alexa speaks when the reminder
+// fires.
+type ReminderAlertInfo struct {
+	SpokenInfo struct {
+		Content []ReminderSpokenText `json:"content"`
+	} `json:"spokenInfo"`
+}
+
+// ReminderPushNotification controls whether a push notification accompanies
+// the reminder.
+type ReminderPushNotification struct {
+	Status string `json:"status"`
+}
+
+// Reminder is the request body for creating or updating a reminder.
+type Reminder struct {
+	RequestTime      string                    `json:"requestTime,omitempty"`
+	Trigger          ReminderTrigger           `json:"trigger"`
+	AlertInfo        ReminderAlertInfo         `json:"alertInfo"`
+	PushNotification *ReminderPushNotification `json:"pushNotification,omitempty"`
+}
+
+// ReminderResponse is returned from the Reminders API after a reminder is
+// created, updated or fetched.
+type ReminderResponse struct {
+	AlertToken  string `json:"alertToken"`
+	CreatedTime string `json:"createdTime"`
+	UpdatedTime string `json:"updatedTime"`
+	Status      string `json:"status"`
+	Version     string `json:"version"`
+}
+
+// RemindersClient calls the Alexa Reminders API.
+type RemindersClient struct {
+	client client
+}
+
+// NewRemindersClient builds a RemindersClient that authenticates with token
+// against endpoint, the APIAccessToken and APIEndpoint from the current
+// request's Context.System. A nil httpClient uses http.DefaultClient.
+func NewRemindersClient(endpoint, token string, httpClient *http.Client) *RemindersClient {
+	return &RemindersClient{client: newClient(endpoint, token, httpClient)}
+}
+
+// Create schedules a new reminder. It requires the alexa::alerts:reminders:skill:readwrite
+// permission; ErrPermissionRequired is returned if the user has not granted it.
+func (c *RemindersClient) Create(ctx context.Context, reminder Reminder) (*ReminderResponse, error) {
+	var result ReminderResponse
+	if err := c.client.post(ctx, "/v1/alerts/reminders", reminder, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Get returns the reminder identified by alertToken.
+func (c *RemindersClient) Get(ctx context.Context, alertToken string) (*ReminderResponse, error) {
+	var result ReminderResponse
+	path := fmt.Sprintf("/v1/alerts/reminders/%s", url.PathEscape(alertToken))
+	if err := c.client.get(ctx, path, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Delete cancels the reminder identified by alertToken.
+func (c *RemindersClient) Delete(ctx context.Context, alertToken string) error {
+	path := fmt.Sprintf("/v1/alerts/reminders/%s", url.PathEscape(alertToken))
+	return c.client.delete(ctx, path)
+}
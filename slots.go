@@ -0,0 +1,96 @@
+package alexa
+
+// Entity resolution status codes returned in
+// IntentSlot.Resolutions.ResolutionsPerAuthority[i].Status.Code.
+const (
+	ResolutionStatusMatch     = "ER_SUCCESS_MATCH"
+	ResolutionStatusNoMatch   = "ER_SUCCESS_NO_MATCH"
+	ResolutionStatusTimeout   = "ER_ERROR_TIMEOUT"
+	ResolutionStatusException = "ER_ERROR_EXCEPTION"
+)
+
+// Request.DialogState values for an IntentRequest that is part of a
+// multi-turn dialog managed by the Dialog model.
+const (
+	DialogStateStarted    = "STARTED"
+	DialogStateInProgress = "IN_PROGRESS"
+	DialogStateCompleted  = "COMPLETED"
+)
+
+// IsDialogComplete reports whether the Request's dialog, if any, has
+// reached the Completed state.
+func (req *Request) IsDialogComplete() bool {
+	return req.DialogState == DialogStateCompleted
+}
+
+// IsMatched reports whether the slot was successfully resolved to one or
+// more catalog entries by at least one authority.
+func (s *IntentSlot) IsMatched() bool {
+	if s.Resolutions == nil {
+		return false
+	}
+	for _, authority := range s.Resolutions.ResolutionsPerAuthority {
+		if authority.Status != nil && authority.Status.Code == ResolutionStatusMatch {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNoMatch reports whether the slot value failed to resolve against every
+// authority that was queried.
+func (s *IntentSlot) IsNoMatch() bool {
+	if s.Resolutions == nil || len(s.Resolutions.ResolutionsPerAuthority) == 0 {
+		return false
+	}
+	for _, authority := range s.Resolutions.ResolutionsPerAuthority {
+		if authority.Status == nil || authority.Status.Code != ResolutionStatusNoMatch {
+			return false
+		}
+	}
+	return true
+}
+
+// FirstResolvedValue returns the name and id of the first resolved entity
+// across all authorities, in the order they appear in the request. ok is
+// false if the slot has no resolutions or none of them matched.
+func (s *IntentSlot) FirstResolvedValue() (name string, id string, ok bool) {
+	if s.Resolutions == nil {
+		return "", "", false
+	}
+	for _, authority := range s.Resolutions.ResolutionsPerAuthority {
+		if authority.Status == nil || authority.Status.Code != ResolutionStatusMatch {
+			continue
+		}
+		for _, value := range authority.Values {
+			if value.Value != nil {
+				return value.Value.Name, value.Value.Id, true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// ResolvedValues returns the resolved entities for the named authority, or
+// nil if the authority was not queried or did not match.
+func (s *IntentSlot) ResolvedValues(authority string) []SlotValue {
+	if s.Resolutions == nil {
+		return nil
+	}
+	for _, a := range s.Resolutions.ResolutionsPerAuthority {
+		if a.Authority != authority {
+			continue
+		}
+		if a.Status == nil || a.Status.Code != ResolutionStatusMatch {
+			return nil
+		}
+		values := make([]SlotValue, 0, len(a.Values))
+		for _, value := range a.Values {
+			if value.Value != nil {
+				values = append(values, *value.Value)
+			}
+		}
+		return values
+	}
+	return nil
+}
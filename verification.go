@@ -0,0 +1,294 @@
+package alexa
+
+import (
+	"bytes"
+	"container/list"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	signatureCertChainURLHeader = "SignatureCertChainUrl"
+	signatureHeader             = "Signature"
+	certChainURLScheme          = "https"
+	certChainURLHost            = "s3.amazonaws.com"
+	certChainURLPathPrefix      = "/echo.api/"
+	certChainURLPort            = "443"
+	certChainSAN                = "echo-api.amazon.com"
+)
+
+// ErrInvalidSignature is returned when the request's Signature header does
+// not match the signed raw body.
+var ErrInvalidSignature = errors.New("alexa: invalid request signature")
+
+// certCacheClient is the http.Client used to fetch certificate chains. It
+// may be overridden with SetCertChainHTTPClient so tests can inject a fake
+// transport instead of hitting S3.
+var certCacheClient = http.DefaultClient
+
+// certChainCacheSize bounds the number of cert chains kept in certCache.
+// Once the cache is full, the least recently used chain is evicted to make
+// room for a new one.
+const certChainCacheSize = 64
+
+// certChainCacheEntry is one cached, already-validated certificate chain.
+// expiresAt tracks the leaf certificate's NotAfter so a chain that was
+// valid when cached but has since expired is refetched rather than trusted
+// forever.
+type certChainCacheEntry struct {
+	chainURL  string
+	chain     []*x509.Certificate
+	expiresAt time.Time
+}
+
+// certCache is a small in-memory LRU cache of parsed, validated certificate
+// chains, keyed by the SignatureCertChainUrl they were fetched from, so
+// that repeated requests from the same device don't refetch and reparse
+// the chain. Entries are evicted once their leaf certificate expires or the
+// cache grows past certChainCacheSize.
+var certCache = struct {
+	sync.Mutex
+	order   *list.List
+	entries map[string]*list.Element
+}{order: list.New(), entries: make(map[string]*list.Element)}
+
+// certCacheGet returns the cached chain for chainURL, or ok=false if there
+// is no entry or the cached entry's leaf certificate has expired.
+func certCacheGet(chainURL string) (chain []*x509.Certificate, ok bool) {
+	certCache.Lock()
+	defer certCache.Unlock()
+
+	elem, found := certCache.entries[chainURL]
+	if !found {
+		return nil, false
+	}
+	entry := elem.Value.(*certChainCacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		certCache.order.Remove(elem)
+		delete(certCache.entries, chainURL)
+		return nil, false
+	}
+
+	certCache.order.MoveToFront(elem)
+	return entry.chain, true
+}
+
+// certCachePut stores chain under chainURL, evicting the least recently
+// used entry if the cache is already at capacity.
+func certCachePut(chainURL string, chain []*x509.Certificate) {
+	certCache.Lock()
+	defer certCache.Unlock()
+
+	if elem, found := certCache.entries[chainURL]; found {
+		entry := elem.Value.(*certChainCacheEntry)
+		entry.chain = chain
+		entry.expiresAt = chain[0].NotAfter
+		certCache.order.MoveToFront(elem)
+		return
+	}
+
+	entry := &certChainCacheEntry{chainURL: chainURL, chain: chain, expiresAt: chain[0].NotAfter}
+	elem := certCache.order.PushFront(entry)
+	certCache.entries[chainURL] = elem
+
+	if certCache.order.Len() > certChainCacheSize {
+		oldest := certCache.order.Back()
+		if oldest != nil {
+			certCache.order.Remove(oldest)
+			delete(certCache.entries, oldest.Value.(*certChainCacheEntry).chainURL)
+		}
+	}
+}
+
+// SetCertChainHTTPClient overrides the http.Client used to download
+// certificate chains. It exists primarily so tests can inject a client that
+// serves a fixed PEM chain instead of making a network call.
+func SetCertChainHTTPClient(client *http.Client) {
+	certCacheClient = client
+}
+
+// VerifyRequest validates the Signature and SignatureCertChainUrl headers on
+// an incoming Alexa request against the raw, unparsed request body. It
+// should be called before the body is unmarshalled into a RequestEnvelope.
+func VerifyRequest(r *http.Request, body []byte) error {
+	chainURL := r.Header.Get(signatureCertChainURLHeader)
+	if chainURL == "" {
+		return errors.New("alexa: missing " + signatureCertChainURLHeader + " header")
+	}
+	signature := r.Header.Get(signatureHeader)
+	if signature == "" {
+		return errors.New("alexa: missing " + signatureHeader + " header")
+	}
+
+	if err := verifyCertChainURL(chainURL); err != nil {
+		return err
+	}
+
+	cert, err := leafCertificate(chainURL)
+	if err != nil {
+		return err
+	}
+
+	if err := verifySignature(cert, signature, body); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IsValidAlexaRequest is a convenience wrapper around VerifyRequest for use
+// directly in an http.HandlerFunc. It reads and restores r.Body, writes an
+// appropriate error status to w on failure, and reports whether the request
+// may proceed.
+func IsValidAlexaRequest(w http.ResponseWriter, r *http.Request) bool {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "alexa: unable to read request body", http.StatusBadRequest)
+		return false
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	if err := VerifyRequest(r, body); err != nil {
+		http.Error(w, "alexa: "+err.Error(), http.StatusUnauthorized)
+		return false
+	}
+
+	return true
+}
+
+// verifyCertChainURL checks the SignatureCertChainUrl against the scheme,
+// host, port and path prefix Amazon requires.
+func verifyCertChainURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("alexa: unable to parse %s: %v", signatureCertChainURLHeader, err)
+	}
+	if u.Scheme != certChainURLScheme {
+		return fmt.Errorf("alexa: %s must use %s, got %s", signatureCertChainURLHeader, certChainURLScheme, u.Scheme)
+	}
+	if !strings.EqualFold(u.Hostname(), certChainURLHost) {
+		return fmt.Errorf("alexa: %s must have host %s, got %s", signatureCertChainURLHeader, certChainURLHost, u.Hostname())
+	}
+	if port := u.Port(); port != "" && port != certChainURLPort {
+		return fmt.Errorf("alexa: %s must use port %s, got %s", signatureCertChainURLHeader, certChainURLPort, port)
+	}
+	if !strings.HasPrefix(u.Path, certChainURLPathPrefix) {
+		return fmt.Errorf("alexa: %s path must start with %s, got %s", signatureCertChainURLHeader, certChainURLPathPrefix, u.Path)
+	}
+
+	return nil
+}
+
+// leafCertificate returns the leaf (first) certificate in the PEM chain at
+// chainURL, fetching and validating the chain if it is not already cached
+// or the cached chain's leaf certificate has since expired.
+func leafCertificate(chainURL string) (*x509.Certificate, error) {
+	if chain, ok := certCacheGet(chainURL); ok {
+		return chain[0], nil
+	}
+
+	chain, err := fetchAndValidateCertChain(chainURL)
+	if err != nil {
+		return nil, err
+	}
+
+	certCachePut(chainURL, chain)
+
+	return chain[0], nil
+}
+
+// fetchAndValidateCertChain downloads the PEM certificate chain at chainURL
+// and verifies that it chains to a trusted root and that the leaf
+// certificate is valid for use with Alexa requests.
+func fetchAndValidateCertChain(chainURL string) ([]*x509.Certificate, error) {
+	resp, err := certCacheClient.Get(chainURL)
+	if err != nil {
+		return nil, fmt.Errorf("alexa: unable to fetch cert chain: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("alexa: unable to fetch cert chain: status %d", resp.StatusCode)
+	}
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("alexa: unable to read cert chain: %v", err)
+	}
+
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("alexa: unable to parse certificate: %v", err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("alexa: cert chain contained no certificates")
+	}
+
+	leaf := certs[0]
+	if time.Now().After(leaf.NotAfter) || time.Now().Before(leaf.NotBefore) {
+		return nil, errors.New("alexa: leaf certificate is expired or not yet valid")
+	}
+
+	sanMatch := false
+	for _, san := range leaf.DNSNames {
+		if strings.EqualFold(san, certChainSAN) {
+			sanMatch = true
+			break
+		}
+	}
+	if !sanMatch {
+		return nil, fmt.Errorf("alexa: leaf certificate does not contain required SAN %s", certChainSAN)
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	if _, err := leaf.Verify(x509.VerifyOptions{Intermediates: intermediates}); err != nil {
+		return nil, fmt.Errorf("alexa: cert chain does not verify to a trusted root: %v", err)
+	}
+
+	return certs, nil
+}
+
+// verifySignature checks the base64-encoded SHA1-with-RSA signature over
+// the raw request body against the given leaf certificate's public key.
+func verifySignature(cert *x509.Certificate, signature string, body []byte) error {
+	decoded, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("alexa: unable to decode %s header: %v", signatureHeader, err)
+	}
+
+	pubKey, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return errors.New("alexa: leaf certificate does not contain an RSA public key")
+	}
+
+	sum := sha1.Sum(body)
+	if err := rsa.VerifyPKCS1v15(pubKey, crypto.SHA1, sum[:], decoded); err != nil {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
@@ -0,0 +1,306 @@
+package alexa
+
+import (
+	"bytes"
+	"container/list"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	testRootCert *x509.Certificate
+	testRootKey  *rsa.PrivateKey
+)
+
+// TestMain generates a throwaway root CA and points Go's x509 system root
+// pool at it via SSL_CERT_FILE, so fetchAndValidateCertChain's call to
+// leaf.Verify (which checks against the system roots) can succeed against
+// certificates minted for these tests instead of requiring real S3 certs.
+func TestMain(m *testing.M) {
+	var err error
+	testRootKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "alexa-skills-kit-golang test root"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &testRootKey.PublicKey, testRootKey)
+	if err != nil {
+		panic(err)
+	}
+	testRootCert, err = x509.ParseCertificate(der)
+	if err != nil {
+		panic(err)
+	}
+
+	rootPEMFile, err := ioutil.TempFile("", "alexa-test-root-*.pem")
+	if err != nil {
+		panic(err)
+	}
+	pem.Encode(rootPEMFile, &pem.Block{Type: "CERTIFICATE", Bytes: der})
+	rootPEMFile.Close()
+	os.Setenv("SSL_CERT_FILE", rootPEMFile.Name())
+
+	code := m.Run()
+	os.Remove(rootPEMFile.Name())
+	os.Exit(code)
+}
+
+// testLeaf bundles a generated leaf certificate with the key it was issued
+// under, and its PEM encoding ready to serve as a SignatureCertChainUrl
+// response body.
+type testLeaf struct {
+	key     *rsa.PrivateKey
+	cert    *x509.Certificate
+	pemData []byte
+}
+
+func newTestLeaf(t *testing.T, dnsNames []string, notBefore, notAfter time.Time) testLeaf {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate leaf key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "echo-api.amazon.com"},
+		DNSNames:     dnsNames,
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, testRootCert, &key.PublicKey, testRootKey)
+	if err != nil {
+		t.Fatalf("create leaf cert: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parse leaf cert: %v", err)
+	}
+	var buf bytes.Buffer
+	pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: der})
+	return testLeaf{key: key, cert: cert, pemData: buf.Bytes()}
+}
+
+// serveCertChain stands up a TLS test server returning pemData for every
+// request, and points certCacheClient at it regardless of the requested
+// host, so https://s3.amazonaws.com/... URLs resolve locally. It restores
+// the previous client on test cleanup.
+func serveCertChain(t *testing.T, pemData []byte) (url string, fetches *int) {
+	t.Helper()
+	count := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		w.Write(pemData)
+	}))
+	t.Cleanup(server.Close)
+
+	addr := server.Listener.Addr().String()
+	previous := certCacheClient
+	certCacheClient = &http.Client{Transport: &http.Transport{
+		DialTLS: func(network, _ string) (net.Conn, error) {
+			return tls.Dial(network, addr, &tls.Config{InsecureSkipVerify: true})
+		},
+	}}
+	t.Cleanup(func() { certCacheClient = previous })
+
+	return "https://s3.amazonaws.com/echo.api/cert.pem", &count
+}
+
+func signBody(t *testing.T, key *rsa.PrivateKey, body []byte) string {
+	t.Helper()
+	sum := sha1.Sum(body)
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA1, sum[:])
+	if err != nil {
+		t.Fatalf("sign body: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(sig)
+}
+
+func resetCertCache() {
+	certCache.Lock()
+	defer certCache.Unlock()
+	certCache.order = list.New()
+	certCache.entries = make(map[string]*list.Element)
+}
+
+func newVerifyHTTPRequest(chainURL, signature string) *http.Request {
+	req, _ := http.NewRequest(http.MethodPost, "https://skill.example.com/", nil)
+	req.Header.Set(signatureCertChainURLHeader, chainURL)
+	req.Header.Set(signatureHeader, signature)
+	return req
+}
+
+func TestVerifyCertChainURL(t *testing.T) {
+	cases := []struct {
+		name    string
+		rawURL  string
+		wantErr string
+	}{
+		{"bad scheme", "http://s3.amazonaws.com/echo.api/cert.pem", "must use https"},
+		{"bad host", "https://evil.com/echo.api/cert.pem", "must have host"},
+		{"bad port", "https://s3.amazonaws.com:8443/echo.api/cert.pem", "must use port"},
+		{"bad path prefix", "https://s3.amazonaws.com/not-echo-api/cert.pem", "path must start with"},
+		{"unparseable", "://not-a-url", "unable to parse"},
+		{"valid", "https://s3.amazonaws.com/echo.api/cert.pem", ""},
+		{"valid with explicit port", "https://s3.amazonaws.com:443/echo.api/cert.pem", ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := verifyCertChainURL(c.rawURL)
+			if c.wantErr == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), c.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", c.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestVerifyRequest_Success(t *testing.T) {
+	resetCertCache()
+	leaf := newTestLeaf(t, []string{"echo-api.amazon.com"}, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	chainURL, fetches := serveCertChain(t, leaf.pemData)
+
+	body := []byte(`{"request":"ping"}`)
+	sig := signBody(t, leaf.key, body)
+
+	if err := VerifyRequest(newVerifyHTTPRequest(chainURL, sig), body); err != nil {
+		t.Fatalf("expected success, got %v", err)
+	}
+	if *fetches != 1 {
+		t.Fatalf("expected 1 fetch, got %d", *fetches)
+	}
+
+	// A second verification against the same chain URL should hit the cache
+	// rather than refetching.
+	if err := VerifyRequest(newVerifyHTTPRequest(chainURL, sig), body); err != nil {
+		t.Fatalf("expected success on second call, got %v", err)
+	}
+	if *fetches != 1 {
+		t.Fatalf("expected cache hit (still 1 fetch), got %d", *fetches)
+	}
+}
+
+func TestVerifyRequest_ExpiredLeafCert(t *testing.T) {
+	resetCertCache()
+	leaf := newTestLeaf(t, []string{"echo-api.amazon.com"}, time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour))
+	chainURL, _ := serveCertChain(t, leaf.pemData)
+
+	body := []byte(`{"request":"ping"}`)
+	sig := signBody(t, leaf.key, body)
+
+	err := VerifyRequest(newVerifyHTTPRequest(chainURL, sig), body)
+	if err == nil || !strings.Contains(err.Error(), "expired") {
+		t.Fatalf("expected expired certificate error, got %v", err)
+	}
+}
+
+func TestVerifyRequest_MissingSAN(t *testing.T) {
+	resetCertCache()
+	leaf := newTestLeaf(t, []string{"not-echo-api.example.com"}, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	chainURL, _ := serveCertChain(t, leaf.pemData)
+
+	body := []byte(`{"request":"ping"}`)
+	sig := signBody(t, leaf.key, body)
+
+	err := VerifyRequest(newVerifyHTTPRequest(chainURL, sig), body)
+	if err == nil || !strings.Contains(err.Error(), "SAN") {
+		t.Fatalf("expected missing SAN error, got %v", err)
+	}
+}
+
+func TestVerifyRequest_InvalidBase64Signature(t *testing.T) {
+	resetCertCache()
+	leaf := newTestLeaf(t, []string{"echo-api.amazon.com"}, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	chainURL, _ := serveCertChain(t, leaf.pemData)
+
+	body := []byte(`{"request":"ping"}`)
+
+	err := VerifyRequest(newVerifyHTTPRequest(chainURL, "not-valid-base64!!!"), body)
+	if err == nil || !strings.Contains(err.Error(), "decode") {
+		t.Fatalf("expected base64 decode error, got %v", err)
+	}
+}
+
+func TestVerifyRequest_BadSignature(t *testing.T) {
+	resetCertCache()
+	leaf := newTestLeaf(t, []string{"echo-api.amazon.com"}, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	chainURL, _ := serveCertChain(t, leaf.pemData)
+
+	body := []byte(`{"request":"ping"}`)
+	sig := signBody(t, leaf.key, []byte(`{"request":"a different body"}`))
+
+	err := VerifyRequest(newVerifyHTTPRequest(chainURL, sig), body)
+	if err != ErrInvalidSignature {
+		t.Fatalf("expected ErrInvalidSignature, got %v", err)
+	}
+}
+
+func TestCertCache_ExpiryEviction(t *testing.T) {
+	resetCertCache()
+	// x509 certificate timestamps round-trip through ASN.1 with only
+	// one-second precision, so the margin here has to clear a full second
+	// rather than relying on sub-second deltas.
+	leaf := newTestLeaf(t, []string{"echo-api.amazon.com"}, time.Now().Add(-time.Hour), time.Now().Add(2*time.Second))
+	certCachePut("https://s3.amazonaws.com/echo.api/short-lived.pem", []*x509.Certificate{leaf.cert})
+
+	if _, ok := certCacheGet("https://s3.amazonaws.com/echo.api/short-lived.pem"); !ok {
+		t.Fatal("expected cache hit before expiry")
+	}
+
+	time.Sleep(2500 * time.Millisecond)
+
+	if _, ok := certCacheGet("https://s3.amazonaws.com/echo.api/short-lived.pem"); ok {
+		t.Fatal("expected cache miss after leaf certificate expired")
+	}
+}
+
+func TestCertCache_LRUEviction(t *testing.T) {
+	resetCertCache()
+	leaf := newTestLeaf(t, []string{"echo-api.amazon.com"}, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+
+	firstURL := "https://s3.amazonaws.com/echo.api/cert-000.pem"
+	certCachePut(firstURL, []*x509.Certificate{leaf.cert})
+
+	for i := 1; i <= certChainCacheSize; i++ {
+		certCachePut(fmt.Sprintf("https://s3.amazonaws.com/echo.api/cert-%03d.pem", i), []*x509.Certificate{leaf.cert})
+	}
+
+	if _, ok := certCacheGet(firstURL); ok {
+		t.Fatal("expected the oldest entry to have been evicted once the cache exceeded its capacity")
+	}
+
+	recentURL := fmt.Sprintf("https://s3.amazonaws.com/echo.api/cert-%03d.pem", certChainCacheSize)
+	if _, ok := certCacheGet(recentURL); !ok {
+		t.Fatal("expected the most recently added entry to still be cached")
+	}
+}